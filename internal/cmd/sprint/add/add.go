@@ -1,7 +1,10 @@
 package add
 
 import (
+	"bufio"
 	"fmt"
+	"io"
+	"os"
 	"strconv"
 	"strings"
 
@@ -18,7 +21,16 @@ import (
 
 const (
 	helpText = `Add issues to sprint.`
-	examples = `$ jira sprint add SPRINT_ID ISSUE-1 ISSUE-2`
+	examples = `$ jira sprint add SPRINT_ID ISSUE-1 ISSUE-2
+
+$ # Read issue keys from stdin, one per line
+$ jira issue list --plain --no-headers --columns key | jira sprint add --current -
+
+$ # Read issue keys from a file, one per line ('#' starts a comment)
+$ jira sprint add SPRINT_ID --from-file issues.txt
+
+$ # Select issues with JQL instead of passing keys, previewing first
+$ jira sprint add SPRINT_ID --jql "project = FOO AND status = 'To Do'" --dry-run`
 )
 
 // NewCmdAdd is an add command.
@@ -31,17 +43,32 @@ func NewCmdAdd() *cobra.Command {
 		Aliases: []string{"assign"},
 		Annotations: map[string]string{
 			"help:args": "SPRINT_ID\t\tID of the sprint on which you want to assign issues to, eg: 123\n" +
-				"ISSUE-1 [...ISSUE-N]\tKey of the issues to add to the sprint (max 50 issues at once)",
+				"ISSUE-1 [...ISSUE-N]\tKey of the issues to add to the sprint (chunked in batches of 50). " +
+				"Pass \"-\" to read keys from stdin instead, one per line",
 		},
 		Run: add,
 	}
 }
 
 func add(cmd *cobra.Command, args []string) {
+	cmdutil.Run(func() error { return runAdd(cmd, args) })
+}
+
+func runAdd(cmd *cobra.Command, args []string) error {
 	server := viper.GetString("server")
 	project := viper.GetString("project.key")
 	boardID := viper.GetInt("board.id")
-	params := parseFlags(cmd.Flags(), args, project, boardID)
+
+	debug, err := cmd.Flags().GetBool("debug")
+	if err != nil {
+		return err
+	}
+	cmdutil.SetDebug(debug)
+
+	params, err := parseFlags(cmd.Flags(), args, project, boardID)
+	if err != nil {
+		return err
+	}
 	client := api.DefaultClient(params.debug)
 
 	qs := getQuestions(params)
@@ -50,8 +77,9 @@ func add(cmd *cobra.Command, args []string) {
 			SprintID string
 			Issues   string
 		}{}
-		err := survey.Ask(qs, &ans)
-		cmdutil.ExitIfError(err)
+		if err := survey.Ask(qs, &ans); err != nil {
+			return &cmdutil.UserError{Msg: err.Error()}
+		}
 
 		if params.sprintID == "" {
 			params.sprintID = ans.SprintID
@@ -66,18 +94,49 @@ func add(cmd *cobra.Command, args []string) {
 		}
 	}
 
-	err := func() error {
+	if len(params.issues) == 0 {
+		return &cmdutil.UserError{Msg: "no issues to add; pass issue keys directly, or use -, --from-file or --jql"}
+	}
+
+	if params.dryRun {
+		return printDryRun(params.sprintID, project, params.issues)
+	}
+
+	result, err := func() (*jira.BulkAddResult, error) {
 		s := cmdutil.Info("Adding issues to the sprint...")
 		defer s.Stop()
 
-		return client.SprintIssuesAdd(params.sprintID, params.issues...)
+		return client.SprintIssuesAdd(params.sprintID, jira.SprintIssuesAddOptions{
+			Concurrency:     params.concurrency,
+			ContinueOnError: params.continueOnError,
+		}, params.issues...)
 	}()
-	cmdutil.ExitIfError(err)
+	if err != nil {
+		printBulkAddSummary(result)
+		return cmdutil.ClassifyAPIError(err)
+	}
 
 	cmdutil.Success(fmt.Sprintf("Issues added to the sprint %s\n%s", params.sprintID, cmdutil.GenerateServerBrowseURL(server, project)))
+	return nil
+}
+
+// printDryRun shows the resolved set of issues without actually adding them
+// to the sprint, using the same table view as `issue list` so the preview
+// looks like what the rest of the CLI would show.
+func printDryRun(sprintID, project string, issues []string) error {
+	fmt.Printf("Dry run: %d issue(s) would be added to sprint %s\n\n", len(issues), sprintID)
+	return view.NewIssue(project, issues).Render(os.Stdout)
+}
+
+func printBulkAddSummary(result *jira.BulkAddResult) {
+	total := len(result.Succeeded) + len(result.Failed) + len(result.Skipped)
+	fmt.Printf("%d/%d added, %d failed, %d skipped:\n", len(result.Succeeded), total, len(result.Failed), len(result.Skipped))
+	for _, f := range result.Failed {
+		fmt.Printf("  %s: %s\n", f.Issue, f.Reason)
+	}
 }
 
-func parseFlags(flags query.FlagParser, args []string, project string, boardID int) *addParams {
+func parseFlags(flags query.FlagParser, args []string, project string, boardID int) (*addParams, error) {
 	var (
 		sprintID string
 		issues   []string
@@ -96,22 +155,42 @@ func parseFlags(flags query.FlagParser, args []string, project string, boardID i
 	debug, err := flags.GetBool("debug")
 	cmdutil.ExitIfError(err)
 
+	createIfMissing, err := flags.GetBool("create-if-missing")
+	cmdutil.ExitIfError(err)
+
+	concurrency, err := flags.GetInt("concurrency")
+	cmdutil.ExitIfError(err)
+
+	continueOnError, err := flags.GetBool("continue-on-error")
+	cmdutil.ExitIfError(err)
+
+	fromFile, err := flags.GetString("from-file")
+	cmdutil.ExitIfError(err)
+
+	jql, err := flags.GetString("jql")
+	cmdutil.ExitIfError(err)
+
+	queryInteractive, err := flags.GetBool("query-interactive")
+	cmdutil.ExitIfError(err)
+
+	dryRun, err := flags.GetBool("dry-run")
+	cmdutil.ExitIfError(err)
+
+	if queryInteractive {
+		jql, err = promptJQL(jql)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	sprintQuery, err := query.NewSprint(flags)
 	cmdutil.ExitIfError(err)
 	nArgs := len(args)
 
 	if next || prev || current {
-		sprints := func() []*jira.Sprint {
-
-			s := cmdutil.Info("Fetching sprints...")
-			defer s.Stop()
-			client := api.DefaultClient(debug)
-
-			return client.SprintsInBoards([]int{boardID}, sprintQuery.Get(), 50)
-		}()
-		sprint := sprints[0]
-		if next {
-			sprint = sprints[len(sprints)-1]
+		sprint, err := cmdutil.SprintFromBoard(api.DefaultClient(debug), boardID, sprintQuery.Get(), next, prev, createIfMissing)
+		if err != nil {
+			return nil, err
 		}
 		sprintID = strconv.Itoa(sprint.ID)
 		if nArgs > 0 {
@@ -125,16 +204,144 @@ func parseFlags(flags query.FlagParser, args []string, project string, boardID i
 			tickets = args[1:]
 		}
 	}
+	issuesExplicit := fromFile != "" || jql != ""
+	for _, t := range tickets {
+		if t == "-" {
+			issuesExplicit = true
+			break
+		}
+	}
+
+	tickets, err = expandTicketSources(tickets, fromFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if jql != "" {
+		tickets, err = searchIssueKeys(api.DefaultClient(debug), jql)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	issues = make([]string, 0, len(tickets))
 	for _, iss := range tickets {
 		issues = append(issues, cmdutil.GetJiraIssueKey(project, iss))
 	}
 
 	return &addParams{
-		sprintID: sprintID,
-		issues:   issues,
-		debug:    debug,
+		sprintID:        sprintID,
+		issues:          issues,
+		issuesExplicit:  issuesExplicit,
+		debug:           debug,
+		concurrency:     concurrency,
+		continueOnError: continueOnError,
+		dryRun:          dryRun,
+	}, nil
+}
+
+// jqlTemplate seeds the --query-interactive editor with a starting point the
+// user can tweak before the search runs.
+const jqlTemplate = `project = "" AND status = "To Do" ORDER BY created ASC`
+
+func promptJQL(prefill string) (string, error) {
+	if prefill == "" {
+		prefill = jqlTemplate
 	}
+
+	var jql string
+	if err := survey.AskOne(&survey.Editor{
+		Message:       "JQL query",
+		Default:       prefill,
+		HideDefault:   true,
+		AppendDefault: true,
+	}, &jql); err != nil {
+		return "", &cmdutil.UserError{Msg: err.Error()}
+	}
+
+	return strings.TrimSpace(jql), nil
+}
+
+// searchIssueKeysPageSize is how many results are requested per page while
+// paginating a JQL search. Jira caps the per-request maxResults well below
+// what's commonly requested, so searchIssueKeys always pages through to
+// res.Total rather than trusting a single response to return everything.
+const searchIssueKeysPageSize = 100
+
+// searchIssueKeys runs jql and returns the keys of all matching issues,
+// paginating until res.Total issues have been collected.
+func searchIssueKeys(client *jira.Client, jql string) ([]string, error) {
+	s := cmdutil.Info("Searching issues...")
+	defer s.Stop()
+
+	var keys []string
+	for {
+		res, err := client.Search(jql, len(keys), searchIssueKeysPageSize)
+		if err != nil {
+			return nil, cmdutil.ClassifyAPIError(err)
+		}
+
+		for _, issue := range res.Issues {
+			keys = append(keys, issue.Key)
+		}
+		if len(keys) >= res.Total || len(res.Issues) == 0 {
+			break
+		}
+	}
+	return keys, nil
+}
+
+// expandTicketSources resolves the "-" stdin sentinel and --from-file into
+// plain issue keys, leaving any keys passed directly as args untouched.
+func expandTicketSources(tickets []string, fromFile string) ([]string, error) {
+	expanded := make([]string, 0, len(tickets))
+	for _, t := range tickets {
+		if t == "-" {
+			keys, err := readIssueKeys(os.Stdin, false)
+			if err != nil {
+				return nil, &cmdutil.UserError{Msg: err.Error()}
+			}
+			expanded = append(expanded, keys...)
+			continue
+		}
+		expanded = append(expanded, t)
+	}
+
+	if fromFile != "" {
+		f, err := os.Open(fromFile)
+		if err != nil {
+			return nil, &cmdutil.UserError{Msg: err.Error()}
+		}
+		defer func() { _ = f.Close() }()
+
+		keys, err := readIssueKeys(f, true)
+		if err != nil {
+			return nil, &cmdutil.UserError{Msg: err.Error()}
+		}
+		expanded = append(expanded, keys...)
+	}
+
+	return expanded, nil
+}
+
+// readIssueKeys reads one issue key per line. Blank lines are skipped, and
+// when allowComments is set, so are lines starting with '#'.
+func readIssueKeys(r io.Reader, allowComments bool) ([]string, error) {
+	var keys []string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || (allowComments && strings.HasPrefix(line, "#")) {
+			continue
+		}
+		keys = append(keys, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
 }
 
 func getQuestions(params *addParams) []*survey.Question {
@@ -147,7 +354,7 @@ func getQuestions(params *addParams) []*survey.Question {
 			Validate: survey.Required,
 		})
 	}
-	if len(params.issues) == 0 {
+	if len(params.issues) == 0 && !params.issuesExplicit && cmdutil.StdinTTY() {
 		qs = append(qs, &survey.Question{
 			Name: "issues",
 			Prompt: &survey.Input{
@@ -173,6 +380,13 @@ func setFlags(cmd *cobra.Command) {
 	cmd.Flags().Bool("current", false, "List issues in current active sprint")
 	cmd.Flags().Bool("prev", false, "List issues in previous sprint")
 	cmd.Flags().Bool("next", false, "List issues in next planned sprint")
+	cmd.Flags().Bool("create-if-missing", false, "Create a sprint on the board if --current/--next/--prev matches none")
+	cmd.Flags().Int("concurrency", 4, "Number of batches of 50 issues to add in parallel")
+	cmd.Flags().Bool("continue-on-error", false, "Keep adding remaining batches after one fails instead of stopping")
+	cmd.Flags().String("from-file", "", "Read issue keys from the given file, one per line ('#' starts a comment)")
+	cmd.Flags().String("jql", "", "Select issues to add using a JQL query instead of passing keys directly")
+	cmd.Flags().Bool("query-interactive", false, "Open an editor pre-filled with a JQL template to refine before running")
+	cmd.Flags().Bool("dry-run", false, "Print the resolved issue keys without adding them to the sprint")
 }
 func SetFlags(cmd *cobra.Command) {
 	setFlags(cmd)
@@ -182,5 +396,12 @@ func SetFlags(cmd *cobra.Command) {
 type addParams struct {
 	sprintID string
 	issues   []string
-	debug    bool
+	// issuesExplicit is set when issues came from -, --from-file or --jql,
+	// so getQuestions knows not to fall back to an interactive prompt just
+	// because the explicit source resolved to nothing.
+	issuesExplicit  bool
+	debug           bool
+	concurrency     int
+	continueOnError bool
+	dryRun          bool
 }
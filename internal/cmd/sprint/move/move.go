@@ -0,0 +1,222 @@
+package move
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+)
+
+const (
+	helpText = `Move issues from one sprint to another.`
+	examples = `$ jira sprint move FROM_SPRINT TO_SPRINT ISSUE-1 ISSUE-2
+
+$ # Move issues out of SPRINT_ID into whatever sprint is currently active
+$ jira sprint move SPRINT_ID --current ISSUE-1 ISSUE-2`
+)
+
+// NewCmdMove is a move command.
+func NewCmdMove() *cobra.Command {
+	return &cobra.Command{
+		Use:     "move FROM_SPRINT TO_SPRINT ISSUE-1 [...ISSUE-N]",
+		Short:   "Move issues between sprints",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"mv"},
+		Annotations: map[string]string{
+			"help:args": "FROM_SPRINT\t\tID of the sprint you want to move issues out of, eg: 123\n" +
+				"TO_SPRINT\t\tID of the sprint you want to move issues into, eg: 456. " +
+				"Omit when using --current/--next/--prev\n" +
+				"ISSUE-1 [...ISSUE-N]\tKey of the issues to move",
+		},
+		Run: move,
+	}
+}
+
+func move(cmd *cobra.Command, args []string) {
+	cmdutil.Run(func() error { return runMove(cmd, args) })
+}
+
+func runMove(cmd *cobra.Command, args []string) error {
+	project := viper.GetString("project.key")
+	boardID := viper.GetInt("board.id")
+
+	debug, err := cmd.Flags().GetBool("debug")
+	if err != nil {
+		return err
+	}
+	cmdutil.SetDebug(debug)
+
+	params, err := parseFlags(cmd.Flags(), args, project, boardID)
+	if err != nil {
+		return err
+	}
+
+	qs := getQuestions(params)
+	if len(qs) > 0 {
+		ans := struct {
+			FromSprintID string
+			ToSprintID   string
+			Issues       string
+		}{}
+		if err := survey.Ask(qs, &ans); err != nil {
+			return &cmdutil.UserError{Msg: err.Error()}
+		}
+
+		if params.fromSprintID == "" {
+			params.fromSprintID = ans.FromSprintID
+		}
+		if params.toSprintID == "" {
+			params.toSprintID = ans.ToSprintID
+		}
+		if len(params.issues) == 0 {
+			issues := strings.Split(ans.Issues, ",")
+			for i, iss := range issues {
+				issues[i] = cmdutil.GetJiraIssueKey(project, strings.TrimSpace(iss))
+			}
+			params.issues = issues
+		}
+	}
+
+	if params.fromSprintID == "" || params.toSprintID == "" {
+		return &cmdutil.UserError{Msg: "both a source and a destination sprint are required"}
+	}
+	if len(params.issues) == 0 {
+		return &cmdutil.UserError{Msg: "at least one issue key is required"}
+	}
+
+	client := api.DefaultClient(params.debug)
+
+	err = func() error {
+		s := cmdutil.Info("Moving issues to the sprint...")
+		defer s.Stop()
+
+		return client.SprintIssuesMove(params.fromSprintID, params.toSprintID, params.issues...)
+	}()
+	if err != nil {
+		return cmdutil.ClassifyAPIError(err)
+	}
+
+	cmdutil.Success(fmt.Sprintf("Issues moved from sprint %s to sprint %s", params.fromSprintID, params.toSprintID))
+	return nil
+}
+
+func parseFlags(flags query.FlagParser, args []string, project string, boardID int) (*moveParams, error) {
+	var (
+		fromSprintID string
+		toSprintID   string
+		issues       []string
+		tickets      []string
+	)
+
+	next, err := flags.GetBool("next")
+	cmdutil.ExitIfError(err)
+
+	prev, err := flags.GetBool("prev")
+	cmdutil.ExitIfError(err)
+
+	current, err := flags.GetBool("current")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	createIfMissing, err := flags.GetBool("create-if-missing")
+	cmdutil.ExitIfError(err)
+
+	sprintQuery, err := query.NewSprint(flags)
+	cmdutil.ExitIfError(err)
+	nArgs := len(args)
+
+	if next || prev || current {
+		sprint, err := cmdutil.SprintFromBoard(api.DefaultClient(debug), boardID, sprintQuery.Get(), next, prev, createIfMissing)
+		if err != nil {
+			return nil, err
+		}
+		toSprintID = strconv.Itoa(sprint.ID)
+		if nArgs > 0 {
+			fromSprintID = args[0]
+		}
+		if nArgs > 1 {
+			tickets = args[1:]
+		}
+	} else {
+		if nArgs > 0 {
+			fromSprintID = args[0]
+		}
+		if nArgs > 1 {
+			toSprintID = args[1]
+		}
+		if nArgs > 2 {
+			tickets = args[2:]
+		}
+	}
+	issues = make([]string, 0, len(tickets))
+	for _, iss := range tickets {
+		issues = append(issues, cmdutil.GetJiraIssueKey(project, iss))
+	}
+
+	return &moveParams{
+		fromSprintID: fromSprintID,
+		toSprintID:   toSprintID,
+		issues:       issues,
+		debug:        debug,
+	}, nil
+}
+
+func getQuestions(params *moveParams) []*survey.Question {
+	var qs []*survey.Question
+
+	if params.fromSprintID == "" {
+		qs = append(qs, &survey.Question{
+			Name:     "fromSprintID",
+			Prompt:   &survey.Input{Message: "From sprint ID"},
+			Validate: survey.Required,
+		})
+	}
+	if params.toSprintID == "" {
+		qs = append(qs, &survey.Question{
+			Name:     "toSprintID",
+			Prompt:   &survey.Input{Message: "To sprint ID"},
+			Validate: survey.Required,
+		})
+	}
+	if len(params.issues) == 0 {
+		qs = append(qs, &survey.Question{
+			Name: "issues",
+			Prompt: &survey.Input{
+				Message: "Issues",
+				Help:    "Comma separated list of issues key to move. eg: ISSUE-1, ISSUE-2",
+			},
+			Validate: survey.Required,
+		})
+	}
+
+	return qs
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("current", false, "Move issues to the current active sprint")
+	cmd.Flags().Bool("prev", false, "Move issues to the previous sprint")
+	cmd.Flags().Bool("next", false, "Move issues to the next planned sprint")
+	cmd.Flags().Bool("create-if-missing", false, "Create the destination sprint on the board if --current/--next/--prev matches none")
+}
+
+// SetFlags sets flags supported by move command.
+func SetFlags(cmd *cobra.Command) {
+	setFlags(cmd)
+}
+
+type moveParams struct {
+	fromSprintID string
+	toSprintID   string
+	issues       []string
+	debug        bool
+}
@@ -0,0 +1,187 @@
+package remove
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+)
+
+const (
+	helpText = `Remove issues from sprint and put them back in the backlog.`
+	examples = `$ jira sprint remove SPRINT_ID ISSUE-1 ISSUE-2`
+)
+
+// NewCmdRemove is a remove command.
+func NewCmdRemove() *cobra.Command {
+	return &cobra.Command{
+		Use:     "remove SPRINT_ID ISSUE-1 [...ISSUE-N]",
+		Short:   "Remove issues from sprint",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"rm", "unassign"},
+		Annotations: map[string]string{
+			"help:args": "SPRINT_ID\t\tID of the sprint you want to remove issues from, eg: 123\n" +
+				"ISSUE-1 [...ISSUE-N]\tKey of the issues to remove from the sprint",
+		},
+		Run: remove,
+	}
+}
+
+func remove(cmd *cobra.Command, args []string) {
+	cmdutil.Run(func() error { return runRemove(cmd, args) })
+}
+
+func runRemove(cmd *cobra.Command, args []string) error {
+	project := viper.GetString("project.key")
+	boardID := viper.GetInt("board.id")
+
+	debug, err := cmd.Flags().GetBool("debug")
+	if err != nil {
+		return err
+	}
+	cmdutil.SetDebug(debug)
+
+	params, err := parseFlags(cmd.Flags(), args, project, boardID)
+	if err != nil {
+		return err
+	}
+	client := api.DefaultClient(params.debug)
+
+	qs := getQuestions(params)
+	if len(qs) > 0 {
+		ans := struct {
+			SprintID string
+			Issues   string
+		}{}
+		if err := survey.Ask(qs, &ans); err != nil {
+			return &cmdutil.UserError{Msg: err.Error()}
+		}
+
+		if params.sprintID == "" {
+			params.sprintID = ans.SprintID
+		}
+
+		if len(params.issues) == 0 {
+			issues := strings.Split(ans.Issues, ",")
+			for i, iss := range issues {
+				issues[i] = cmdutil.GetJiraIssueKey(project, strings.TrimSpace(iss))
+			}
+			params.issues = issues
+		}
+	}
+
+	err = func() error {
+		s := cmdutil.Info("Removing issues from the sprint...")
+		defer s.Stop()
+
+		return client.SprintIssuesRemove(params.sprintID, params.issues...)
+	}()
+	if err != nil {
+		return cmdutil.ClassifyAPIError(err)
+	}
+
+	cmdutil.Success("Issues removed from the sprint and returned to the backlog")
+	return nil
+}
+
+func parseFlags(flags query.FlagParser, args []string, project string, boardID int) (*removeParams, error) {
+	var (
+		sprintID string
+		issues   []string
+		tickets  []string
+	)
+
+	next, err := flags.GetBool("next")
+	cmdutil.ExitIfError(err)
+
+	prev, err := flags.GetBool("prev")
+	cmdutil.ExitIfError(err)
+
+	current, err := flags.GetBool("current")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	sprintQuery, err := query.NewSprint(flags)
+	cmdutil.ExitIfError(err)
+	nArgs := len(args)
+
+	if next || prev || current {
+		// Removing issues from a sprint that doesn't exist yet makes no sense,
+		// so unlike `sprint add` this never provisions one.
+		sprint, err := cmdutil.SprintFromBoard(api.DefaultClient(debug), boardID, sprintQuery.Get(), next, prev, false)
+		if err != nil {
+			return nil, err
+		}
+		sprintID = strconv.Itoa(sprint.ID)
+		if nArgs > 0 {
+			tickets = args
+		}
+	} else {
+		if nArgs > 0 {
+			sprintID = args[0]
+		}
+		if nArgs > 1 {
+			tickets = args[1:]
+		}
+	}
+	issues = make([]string, 0, len(tickets))
+	for _, iss := range tickets {
+		issues = append(issues, cmdutil.GetJiraIssueKey(project, iss))
+	}
+
+	return &removeParams{
+		sprintID: sprintID,
+		issues:   issues,
+		debug:    debug,
+	}, nil
+}
+
+func getQuestions(params *removeParams) []*survey.Question {
+	var qs []*survey.Question
+
+	if params.sprintID == "" {
+		qs = append(qs, &survey.Question{
+			Name:     "sprintID",
+			Prompt:   &survey.Input{Message: "Sprint ID"},
+			Validate: survey.Required,
+		})
+	}
+	if len(params.issues) == 0 {
+		qs = append(qs, &survey.Question{
+			Name: "issues",
+			Prompt: &survey.Input{
+				Message: "Issues",
+				Help:    "Comma separated list of issues key to remove. eg: ISSUE-1, ISSUE-2",
+			},
+			Validate: survey.Required,
+		})
+	}
+
+	return qs
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("current", false, "Remove issues from the current active sprint")
+	cmd.Flags().Bool("prev", false, "Remove issues from the previous sprint")
+	cmd.Flags().Bool("next", false, "Remove issues from the next planned sprint")
+}
+
+// SetFlags sets flags supported by remove command.
+func SetFlags(cmd *cobra.Command) {
+	setFlags(cmd)
+}
+
+type removeParams struct {
+	sprintID string
+	issues   []string
+	debug    bool
+}
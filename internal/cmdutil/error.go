@@ -0,0 +1,131 @@
+package cmdutil
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"runtime/debug"
+
+	"github.com/fatih/color"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Exit codes returned by commands, keyed to the class of error encountered.
+// Scripts driving the CLI can rely on these instead of parsing stderr.
+const (
+	ExitCodeOK = iota
+	ExitCodeUser
+	ExitCodeAPI
+	ExitCodeAuth
+	ExitCodeNotFound
+	ExitCodeNetwork
+)
+
+// UserError indicates a problem with how the command was invoked, eg: a bad
+// flag combination or a missing required argument.
+type UserError struct{ Msg string }
+
+func (e *UserError) Error() string { return e.Msg }
+
+// APIError wraps a failure talking to the Jira API. Body is the raw response
+// body, printed only when --debug is set.
+type APIError struct {
+	Msg  string
+	Body string
+}
+
+func (e *APIError) Error() string { return e.Msg }
+
+// AuthError indicates the configured credentials were rejected by Jira.
+type AuthError struct{ Msg string }
+
+func (e *AuthError) Error() string { return e.Msg }
+
+// NotFoundError indicates the requested issue, sprint, or board doesn't exist.
+type NotFoundError struct{ Msg string }
+
+func (e *NotFoundError) Error() string { return e.Msg }
+
+// NetworkError indicates the request never reached Jira at all, eg: a DNS
+// failure, a timeout, or a refused connection, as opposed to Jira responding
+// with an error status.
+type NetworkError struct{ Msg string }
+
+func (e *NetworkError) Error() string { return e.Msg }
+
+var debugMode bool
+
+// SetDebug toggles whether Run prints API response bodies and a stack trace
+// on failure, in addition to the usual one-line summary.
+func SetDebug(d bool) { debugMode = d }
+
+// ClassifyAPIError turns a raw *jira.APIError into the typed error hierarchy
+// above based on its status code, so callers can return it straight from Run
+// without inspecting HTTP details themselves. err is unwrapped with
+// errors.As first, so callers can pass a higher-level error (eg:
+// *jira.BulkAddError) that merely wraps the error that actually came back
+// from Jira and still get it classified correctly.
+func ClassifyAPIError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var ae *jira.APIError
+	if errors.As(err, &ae) {
+		switch ae.StatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return &AuthError{Msg: "authentication failed; check your credentials and token"}
+		case http.StatusNotFound:
+			return &NotFoundError{Msg: "the requested resource was not found"}
+		default:
+			return &APIError{Msg: err.Error(), Body: ae.Body}
+		}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return &NetworkError{Msg: fmt.Sprintf("could not reach jira: %s", err.Error())}
+	}
+
+	return err
+}
+
+// Run executes fn and, on error, prints a colored one-line summary and exits
+// with a code that reflects the error class (see the ExitCode* constants).
+// With --debug set, it additionally prints the wrapped Jira response body, if
+// any, and a Go stack trace.
+func Run(fn func() error) {
+	err := fn()
+	if err == nil {
+		return
+	}
+
+	code := ExitCodeUser
+	var apiErr *APIError
+
+	switch e := err.(type) {
+	case *APIError:
+		code = ExitCodeAPI
+		apiErr = e
+	case *AuthError:
+		code = ExitCodeAuth
+	case *NotFoundError:
+		code = ExitCodeNotFound
+	case *NetworkError:
+		code = ExitCodeNetwork
+	}
+
+	_, _ = color.New(color.FgRed).Fprintf(os.Stderr, "✗ %s\n", err.Error())
+
+	if debugMode {
+		if apiErr != nil && apiErr.Body != "" {
+			fmt.Fprintln(os.Stderr, apiErr.Body)
+		}
+		fmt.Fprintln(os.Stderr, string(debug.Stack()))
+	}
+
+	os.Exit(code)
+}
@@ -0,0 +1,70 @@
+package cmdutil
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// defaultSprintDuration is used to provision a sprint's end date when one is
+// created on the fly via --create-if-missing.
+const defaultSprintDuration = 14 * 24 * time.Hour
+
+// SprintFromBoard resolves a single sprint off a board using the usual
+// --next/--current/--prev flag semantics shared by the sprint subcommands.
+//
+// When no sprint matches the requested state, it returns a UserError unless
+// createIfMissing is set, in which case a new sprint is provisioned on the
+// board and returned instead. createIfMissing only applies to --current and
+// --next: a "previous" sprint is by definition one that already happened, so
+// auto-creating one to satisfy --prev makes no sense. Callers should return
+// the error as-is so it flows through cmdutil.Run like any other failure.
+func SprintFromBoard(client *jira.Client, boardID int, state string, next, prev, createIfMissing bool) (*jira.Sprint, error) {
+	label := "active"
+	switch {
+	case next:
+		label = "next"
+	case prev:
+		label = "previous"
+	}
+
+	s := Info("Fetching sprints...")
+	sprints := client.SprintsInBoards([]int{boardID}, state, 50)
+	s.Stop()
+
+	if len(sprints) == 0 {
+		if prev {
+			return nil, &UserError{Msg: fmt.Sprintf("no previous sprint on board %d; use `jira sprint list` to inspect", boardID)}
+		}
+		if !createIfMissing {
+			return nil, &UserError{Msg: fmt.Sprintf(
+				"no %s sprint on board %d; use `jira sprint list` to inspect, or pass --create-if-missing", label, boardID,
+			)}
+		}
+
+		start := time.Now()
+		end := start.Add(defaultSprintDuration)
+
+		sc := Info("No matching sprint found, creating one...")
+		sprint, err := client.SprintCreate(boardID, fmt.Sprintf("Sprint %s", start.Format("2006-01-02")), &start, &end)
+		if err == nil && !next {
+			// A freshly created sprint starts out in the "future" state,
+			// which is wrong for --current: start it so it's actually the
+			// active sprint the next `sprint list --current` will report.
+			sprint, err = client.SprintStart(sprint.ID)
+		}
+		sc.Stop()
+		if err != nil {
+			return nil, ClassifyAPIError(err)
+		}
+
+		return sprint, nil
+	}
+
+	sprint := sprints[0]
+	if next {
+		sprint = sprints[len(sprints)-1]
+	}
+	return sprint, nil
+}
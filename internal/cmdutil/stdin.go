@@ -0,0 +1,14 @@
+package cmdutil
+
+import "os"
+
+// StdinTTY reports whether stdin is attached to an interactive terminal. When
+// it isn't (a pipe, a redirected file, ...), commands should avoid blocking on
+// an interactive prompt that reads from stdin since no one is there to answer it.
+func StdinTTY() bool {
+	stat, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return stat.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,25 @@
+package jira
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// APIError represents an unexpected response from the Jira API. StatusCode
+// and Body are kept around so callers can tell a permission problem from a
+// missing resource from a generic failure, and so --debug can print the raw
+// response.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("unexpected response from jira: %s", http.StatusText(e.StatusCode))
+}
+
+func formatUnexpectedResponse(res *http.Response) error {
+	body, _ := io.ReadAll(res.Body)
+	return &APIError{StatusCode: res.StatusCode, Body: string(body)}
+}
@@ -0,0 +1,299 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	sprintAPI       = "/sprint"
+	sprintByIDAPI   = "/sprint/%d"
+	sprintIssueAPI  = "/sprint/%s/issue"
+	backlogIssueAPI = "/backlog/issue"
+
+	jiraDateFormat = "2006-01-02T15:04:05.000Z"
+
+	// sprintIssueAddBatchSize is Jira's documented limit of issues that can be
+	// added to a sprint in a single request.
+	sprintIssueAddBatchSize = 50
+
+	defaultSprintIssuesAddConcurrency = 4
+)
+
+// SprintIssuesAddOptions configures how SprintIssuesAdd batches and fans out
+// issues across requests.
+type SprintIssuesAddOptions struct {
+	// Concurrency is the number of batches sent in parallel. Defaults to
+	// defaultSprintIssuesAddConcurrency when <= 0.
+	Concurrency int
+	// ContinueOnError keeps submitting the remaining batches after one fails
+	// instead of stopping at the first failure.
+	ContinueOnError bool
+}
+
+// BulkAddFailure is a single issue that couldn't be added to a sprint, along
+// with the reason its batch failed.
+type BulkAddFailure struct {
+	Issue  string
+	Reason error
+}
+
+// BulkAddResult is the per-issue outcome of a (possibly chunked)
+// SprintIssuesAdd call.
+type BulkAddResult struct {
+	Succeeded []string
+	Failed    []BulkAddFailure
+	// Skipped holds issues whose batch was never sent because an earlier
+	// batch failed and ContinueOnError wasn't set. Always empty when
+	// ContinueOnError is true, since every batch is attempted in that case.
+	Skipped []string
+}
+
+// BulkAddError reports a SprintIssuesAdd call that failed for at least one
+// issue. It wraps a representative underlying batch error (the kind
+// cmdutil.ClassifyAPIError knows how to turn into an AuthError/NotFoundError/
+// NetworkError) so callers don't lose that detail behind a generic summary.
+type BulkAddError struct {
+	Failed  int
+	Total   int
+	Skipped int
+	Err     error
+}
+
+func (e *BulkAddError) Error() string {
+	if e.Skipped > 0 {
+		return fmt.Sprintf(
+			"%d of %d issues failed to add to the sprint (%d more skipped after an earlier batch failed): %s",
+			e.Failed, e.Total, e.Skipped, e.Err,
+		)
+	}
+	return fmt.Sprintf("%d of %d issues failed to add to the sprint: %s", e.Failed, e.Total, e.Err)
+}
+
+func (e *BulkAddError) Unwrap() error { return e.Err }
+
+// SprintIssuesAdd adds issues to the given sprint. Issues are transparently
+// split into batches of sprintIssueAddBatchSize (Jira's documented limit) and
+// fanned out across a bounded worker pool so that callers can pass arbitrarily
+// many keys in one call.
+func (c *Client) SprintIssuesAdd(sprintID string, opts SprintIssuesAddOptions, issues ...string) (*BulkAddResult, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultSprintIssuesAddConcurrency
+	}
+
+	batches := chunkIssues(issues, sprintIssueAddBatchSize)
+	result := &BulkAddResult{}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, concurrency)
+		aborted bool
+	)
+
+	for i, batch := range batches {
+		sem <- struct{}{}
+		mu.Lock()
+		stop := aborted
+		mu.Unlock()
+		if stop {
+			<-sem
+			for _, skipped := range batches[i:] {
+				result.Skipped = append(result.Skipped, skipped...)
+			}
+			break
+		}
+
+		batch := batch
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			err := c.sprintIssuesAddBatch(sprintID, batch)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				for _, issue := range batch {
+					result.Failed = append(result.Failed, BulkAddFailure{Issue: issue, Reason: err})
+				}
+				if !opts.ContinueOnError {
+					aborted = true
+				}
+			} else {
+				result.Succeeded = append(result.Succeeded, batch...)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(result.Failed) > 0 {
+		return result, &BulkAddError{
+			Failed:  len(result.Failed),
+			Total:   len(issues),
+			Skipped: len(result.Skipped),
+			Err:     result.Failed[0].Reason,
+		}
+	}
+	return result, nil
+}
+
+func (c *Client) sprintIssuesAddBatch(sprintID string, issues []string) error {
+	body, err := json.Marshal(struct {
+		Issues []string `json:"issues"`
+	}{Issues: issues})
+	if err != nil {
+		return err
+	}
+
+	res, err := c.Request(http.MethodPost, fmt.Sprintf(sprintIssueAPI, sprintID), body, Header{
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+func chunkIssues(issues []string, size int) [][]string {
+	if len(issues) == 0 {
+		return nil
+	}
+
+	chunks := make([][]string, 0, (len(issues)+size-1)/size)
+	for size < len(issues) {
+		issues, chunks = issues[size:], append(chunks, issues[:size:size])
+	}
+	return append(chunks, issues)
+}
+
+// SprintIssuesRemove removes issues from whatever sprint they're currently in
+// and puts them back in the backlog. The Jira agile API's backlog endpoint
+// isn't scoped to a sprint, so sprintID is accepted for a consistent call
+// signature across sprint subcommands but isn't sent to Jira or verified
+// against the issues' actual sprint.
+//
+// Like SprintIssuesAdd, issues are chunked into batches of
+// sprintIssueAddBatchSize since the backlog endpoint shares the same bulk
+// size cap; the first batch error is returned and remaining batches are
+// skipped.
+func (c *Client) SprintIssuesRemove(_ string, issues ...string) error {
+	for _, batch := range chunkIssues(issues, sprintIssueAddBatchSize) {
+		if err := c.sprintIssuesRemoveBatch(batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) sprintIssuesRemoveBatch(issues []string) error {
+	body, err := json.Marshal(struct {
+		Issues []string `json:"issues"`
+	}{Issues: issues})
+	if err != nil {
+		return err
+	}
+
+	res, err := c.Request(http.MethodPost, backlogIssueAPI, body, Header{
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+// SprintIssuesMove moves issues from one sprint to another.
+//
+// The Jira agile API has no dedicated "move between sprints" endpoint, so this
+// is implemented as an add to the destination sprint, which implicitly pulls
+// the issues out of whatever sprint they were previously in.
+func (c *Client) SprintIssuesMove(_, toSprintID string, issues ...string) error {
+	_, err := c.SprintIssuesAdd(toSprintID, SprintIssuesAddOptions{}, issues...)
+	return err
+}
+
+// SprintCreate creates a new sprint on the given board.
+func (c *Client) SprintCreate(boardID int, name string, startDate, endDate *time.Time) (*Sprint, error) {
+	payload := struct {
+		Name          string `json:"name"`
+		OriginBoardID int    `json:"originBoardId"`
+		StartDate     string `json:"startDate,omitempty"`
+		EndDate       string `json:"endDate,omitempty"`
+	}{
+		Name:          name,
+		OriginBoardID: boardID,
+	}
+	if startDate != nil {
+		payload.StartDate = startDate.UTC().Format(jiraDateFormat)
+	}
+	if endDate != nil {
+		payload.EndDate = endDate.UTC().Format(jiraDateFormat)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.Request(http.MethodPost, sprintAPI, body, Header{
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Sprint
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SprintStart transitions a sprint into the active state.
+func (c *Client) SprintStart(sprintID int) (*Sprint, error) {
+	body, err := json.Marshal(struct {
+		State string `json:"state"`
+	}{State: "active"})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.Request(http.MethodPost, fmt.Sprintf(sprintByIDAPI, sprintID), body, Header{
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Sprint
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}